@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hamba/avro/v2"
+)
+
+// SendAvroEvent encodes v as Avro using schema, resolves schema's registry
+// ID for subject via registry (registering the schema if it's new), and
+// sends the encoded payload with the SchemaId/SchemaFormat properties Event
+// Hubs' own schema-registry serializer sets, so consumers can decode without
+// out-of-band schema distribution.
+func (p *EventHubProducer) SendAvroEvent(ctx context.Context, registry SchemaRegistry, subject string, schema string, v any) error {
+	avroSchema, err := avro.Parse(schema)
+	if err != nil {
+		return fmt.Errorf("failed to parse Avro schema: %v", err)
+	}
+
+	body, err := avro.Marshal(avroSchema, v)
+	if err != nil {
+		return fmt.Errorf("failed to encode value as Avro: %v", err)
+	}
+
+	schemaID, err := registry.SchemaID(ctx, subject, schema)
+	if err != nil {
+		return err
+	}
+
+	event := Event{
+		Body: body,
+		Properties: map[string]any{
+			"SchemaId":     schemaID,
+			"SchemaFormat": "Avro",
+		},
+	}
+
+	return p.SendEvents(ctx, []Event{event})
+}
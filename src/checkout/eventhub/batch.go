@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+)
+
+// Event is a single message to publish to EventHub via SendEvents.
+type Event struct {
+	Body         []byte
+	PartitionKey *string
+	PartitionID  *string
+	Properties   map[string]any
+	ContentType  *string
+}
+
+func (e Event) toEventData() *azeventhubs.EventData {
+	return &azeventhubs.EventData{
+		Body:        e.Body,
+		Properties:  e.Properties,
+		ContentType: e.ContentType,
+	}
+}
+
+func (e Event) batchOptions() *azeventhubs.EventDataBatchOptions {
+	return &azeventhubs.EventDataBatchOptions{
+		PartitionKey: e.PartitionKey,
+		PartitionID:  e.PartitionID,
+	}
+}
+
+func samePartition(a, b Event) bool {
+	return stringPtrEqual(a.PartitionKey, b.PartitionKey) && stringPtrEqual(a.PartitionID, b.PartitionID)
+}
+
+func stringPtrEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// MaxBatchBytes returns the maximum size, in bytes, of a single
+// EventDataBatch as reported by the broker. BatchingProducer uses it to
+// clamp its flush threshold to what a batch can actually hold.
+func (p *EventHubProducer) MaxBatchBytes(ctx context.Context) (uint64, error) {
+	if p.client == nil {
+		return 0, fmt.Errorf("EventHub client is not initialized")
+	}
+
+	batch, err := p.client.NewEventDataBatch(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create event batch: %v", err)
+	}
+	return batch.MaxBytes(), nil
+}
+
+// SendEvents batches events and sends them to EventHub, grouping consecutive
+// events that share a partition key/ID into the same batch so Event Hubs'
+// throughput optimization isn't thrown away the way a per-message SendEvent
+// call would. A batch is sealed and sent whenever the next event targets a
+// different partition, or when the broker reports the current batch is full
+// (ErrEventDataTooLarge); the event that didn't fit is retried against a
+// fresh batch.
+func (p *EventHubProducer) SendEvents(ctx context.Context, events []Event) error {
+	if p.client == nil {
+		return fmt.Errorf("EventHub client is not initialized")
+	}
+
+	var batch *azeventhubs.EventDataBatch
+	var batchCount int
+	var batchPartition Event
+
+	flush := func() error {
+		if batch == nil {
+			return nil
+		}
+		if err := p.client.SendEventDataBatch(ctx, batch, nil); err != nil {
+			return fmt.Errorf("failed to send event batch: %v", err)
+		}
+		p.logger.Info("Event batch sent successfully to EventHub",
+			slog.String("eventhub", p.eventHubName),
+			slog.Int("batch_size", batchCount))
+		batch = nil
+		batchCount = 0
+		return nil
+	}
+
+	for _, event := range events {
+		event = p.applyPartitionStrategy(event)
+
+		if batch != nil && !samePartition(batchPartition, event) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		if batch == nil {
+			batchPartition = event
+			newBatch, err := p.client.NewEventDataBatch(ctx, event.batchOptions())
+			if err != nil {
+				return fmt.Errorf("failed to create event batch: %v", err)
+			}
+			batch = newBatch
+		}
+
+		if err := batch.AddEventData(event.toEventData(), nil); err != nil {
+			if !errors.Is(err, azeventhubs.ErrEventDataTooLarge) {
+				return fmt.Errorf("failed to add event to batch: %v", err)
+			}
+
+			if err := flush(); err != nil {
+				return err
+			}
+
+			newBatch, err := p.client.NewEventDataBatch(ctx, event.batchOptions())
+			if err != nil {
+				return fmt.Errorf("failed to create event batch: %v", err)
+			}
+			batch = newBatch
+
+			if err := batch.AddEventData(event.toEventData(), nil); err != nil {
+				return fmt.Errorf("event is too large to fit in an empty batch: %v", err)
+			}
+		}
+
+		batchCount++
+	}
+
+	return flush()
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import "testing"
+
+func strPtr(s string) *string { return &s }
+
+func TestStringPtrEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *string
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"one nil", strPtr("a"), nil, false},
+		{"equal values", strPtr("a"), strPtr("a"), true},
+		{"different values", strPtr("a"), strPtr("b"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stringPtrEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("stringPtrEqual(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamePartition(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Event
+		want bool
+	}{
+		{"both unpartitioned", Event{}, Event{}, true},
+		{"same key", Event{PartitionKey: strPtr("k")}, Event{PartitionKey: strPtr("k")}, true},
+		{"different key", Event{PartitionKey: strPtr("k1")}, Event{PartitionKey: strPtr("k2")}, false},
+		{"same partition id", Event{PartitionID: strPtr("0")}, Event{PartitionID: strPtr("0")}, true},
+		{"key vs no key", Event{PartitionKey: strPtr("k")}, Event{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := samePartition(tt.a, tt.b); got != tt.want {
+				t.Errorf("samePartition(%+v, %+v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPartitionStrategy(t *testing.T) {
+	p := &EventHubProducer{partitionStrategy: Fixed("customer-42")}
+
+	event := p.applyPartitionStrategy(Event{Body: []byte("payload")})
+	if event.PartitionKey == nil || *event.PartitionKey != "customer-42" {
+		t.Fatalf("applyPartitionStrategy() PartitionKey = %v, want customer-42", event.PartitionKey)
+	}
+
+	explicit := Event{Body: []byte("payload"), PartitionKey: strPtr("explicit")}
+	event = p.applyPartitionStrategy(explicit)
+	if event.PartitionKey == nil || *event.PartitionKey != "explicit" {
+		t.Fatalf("applyPartitionStrategy() overrode an explicit PartitionKey: got %v", event.PartitionKey)
+	}
+}
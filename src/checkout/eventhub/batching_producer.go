@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchingProducerConfig configures a BatchingProducer.
+type BatchingProducerConfig struct {
+	// MaxBatchBytes caps how many bytes of event bodies are buffered before
+	// a flush is triggered. It is clamped to the broker-reported per-batch
+	// byte limit (EventHubProducer.MaxBatchBytes); zero, or a value above
+	// that limit, uses the broker limit as-is.
+	MaxBatchBytes uint64
+	// MaxLingerDuration is the longest a buffered event waits for more
+	// events to arrive before being flushed. Defaults to 5 seconds.
+	MaxLingerDuration time.Duration
+}
+
+// EventResult reports the outcome of sending a single event that was
+// enqueued on a BatchingProducer.
+type EventResult struct {
+	Event Event
+	Err   error
+}
+
+// BatchingProducer buffers events passed to Enqueue and flushes them as
+// batches on a background goroutine, either once MaxBatchBytes worth of
+// events has accumulated or MaxLingerDuration has elapsed since the first
+// buffered event, whichever comes first.
+type BatchingProducer struct {
+	producer *EventHubProducer
+	config   BatchingProducerConfig
+	results  chan EventResult
+
+	mu           sync.Mutex
+	pending      []Event
+	pendingBytes uint64
+	flushTimer   *time.Timer
+
+	flushCh chan struct{}
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewBatchingProducer creates a BatchingProducer that flushes through
+// producer and starts its background flush loop. It queries producer for
+// the broker's per-batch byte limit and clamps config.MaxBatchBytes to it.
+func NewBatchingProducer(ctx context.Context, producer *EventHubProducer, config BatchingProducerConfig) (*BatchingProducer, error) {
+	if config.MaxLingerDuration <= 0 {
+		config.MaxLingerDuration = 5 * time.Second
+	}
+
+	brokerMaxBytes, err := producer.MaxBatchBytes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine broker max batch bytes: %v", err)
+	}
+	if config.MaxBatchBytes == 0 || config.MaxBatchBytes > brokerMaxBytes {
+		config.MaxBatchBytes = brokerMaxBytes
+	}
+
+	bp := &BatchingProducer{
+		producer: producer,
+		config:   config,
+		results:  make(chan EventResult, 128),
+		flushCh:  make(chan struct{}, 1),
+		closeCh:  make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+
+	go bp.run()
+	return bp, nil
+}
+
+// Results returns the channel per-event send outcomes are published on.
+// Callers should drain it to avoid backpressure once it fills.
+func (bp *BatchingProducer) Results() <-chan EventResult {
+	return bp.results
+}
+
+// Enqueue buffers an event for a future flush and returns immediately. The
+// outcome of the send is delivered later on Results.
+func (bp *BatchingProducer) Enqueue(event Event) {
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	bp.pending = append(bp.pending, event)
+	bp.pendingBytes += uint64(len(event.Body))
+
+	if len(bp.pending) == 1 {
+		bp.resetTimerLocked()
+	}
+
+	if bp.config.MaxBatchBytes > 0 && bp.pendingBytes >= bp.config.MaxBatchBytes {
+		bp.triggerFlush()
+	}
+}
+
+func (bp *BatchingProducer) resetTimerLocked() {
+	if bp.flushTimer != nil {
+		bp.flushTimer.Stop()
+	}
+	bp.flushTimer = time.AfterFunc(bp.config.MaxLingerDuration, bp.triggerFlush)
+}
+
+func (bp *BatchingProducer) triggerFlush() {
+	select {
+	case bp.flushCh <- struct{}{}:
+	default:
+	}
+}
+
+func (bp *BatchingProducer) run() {
+	defer close(bp.doneCh)
+	for {
+		select {
+		case <-bp.flushCh:
+			bp.flush()
+		case <-bp.closeCh:
+			bp.flush()
+			return
+		}
+	}
+}
+
+func (bp *BatchingProducer) flush() {
+	bp.mu.Lock()
+	events := bp.pending
+	bp.pending = nil
+	bp.pendingBytes = 0
+	if bp.flushTimer != nil {
+		bp.flushTimer.Stop()
+	}
+	bp.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	err := bp.producer.SendEvents(context.Background(), events)
+	for _, event := range events {
+		bp.results <- EventResult{Event: event, Err: err}
+	}
+}
+
+// Close flushes any buffered events and stops the background flush
+// goroutine. It does not close the underlying EventHubProducer.
+func (bp *BatchingProducer) Close(ctx context.Context) error {
+	close(bp.closeCh)
+
+	select {
+	case <-bp.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	close(bp.results)
+	return nil
+}
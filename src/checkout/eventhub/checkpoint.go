@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/checkpoints"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+)
+
+// newCheckpointStore returns a Blob-backed checkpoint store when
+// config.StorageContainerURL is set, or an in-memory store otherwise.
+func newCheckpointStore(config EventHubConsumerConfig, logger *slog.Logger) (azeventhubs.CheckpointStore, error) {
+	if config.StorageContainerURL == "" {
+		logger.Info("No storage container configured, using in-memory checkpoint store (not suitable for multi-instance deployments)")
+		return NewInMemoryCheckpointStore(), nil
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure credential for checkpoint store: %v", err)
+	}
+
+	containerClient, err := container.NewClient(config.StorageContainerURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob container client: %v", err)
+	}
+
+	store, err := checkpoints.NewBlobStore(containerClient, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob checkpoint store: %v", err)
+	}
+
+	logger.Info("Using blob-backed checkpoint store", slog.String("container_url", config.StorageContainerURL))
+	return store, nil
+}
+
+// checkpointKey groups the namespace/eventhub/consumergroup/partition tuple
+// that a checkpoint or ownership record is keyed on, matching the layout the
+// Blob-backed store uses for its blob names.
+type checkpointKey struct {
+	namespace     string
+	eventHubName  string
+	consumerGroup string
+	partitionID   string
+}
+
+// InMemoryCheckpointStore is an azeventhubs.CheckpointStore backed by a
+// process-local map. It is intended for local development and tests; because
+// it isn't shared, only a single consumer instance can safely use it for a
+// given consumer group.
+type InMemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[checkpointKey]azeventhubs.Checkpoint
+	ownerships  map[checkpointKey]azeventhubs.Ownership
+}
+
+// NewInMemoryCheckpointStore creates an empty InMemoryCheckpointStore.
+func NewInMemoryCheckpointStore() *InMemoryCheckpointStore {
+	return &InMemoryCheckpointStore{
+		checkpoints: map[checkpointKey]azeventhubs.Checkpoint{},
+		ownerships:  map[checkpointKey]azeventhubs.Ownership{},
+	}
+}
+
+func keyFor(namespace, eventHubName, consumerGroup, partitionID string) checkpointKey {
+	return checkpointKey{
+		namespace:     namespace,
+		eventHubName:  eventHubName,
+		consumerGroup: consumerGroup,
+		partitionID:   partitionID,
+	}
+}
+
+// ClaimOwnership implements azeventhubs.CheckpointStore.
+func (s *InMemoryCheckpointStore) ClaimOwnership(ctx context.Context, partitionOwnership []azeventhubs.Ownership, options *azeventhubs.ClaimOwnershipOptions) ([]azeventhubs.Ownership, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claimed := make([]azeventhubs.Ownership, 0, len(partitionOwnership))
+
+	for _, ownership := range partitionOwnership {
+		key := keyFor(ownership.FullyQualifiedNamespace, ownership.EventHubName, ownership.ConsumerGroup, ownership.PartitionID)
+		s.ownerships[key] = ownership
+		claimed = append(claimed, ownership)
+	}
+
+	return claimed, nil
+}
+
+// ListCheckpoints implements azeventhubs.CheckpointStore.
+func (s *InMemoryCheckpointStore) ListCheckpoints(ctx context.Context, namespace string, eventHubName string, consumerGroup string, options *azeventhubs.ListCheckpointsOptions) ([]azeventhubs.Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]azeventhubs.Checkpoint, 0)
+	for key, checkpoint := range s.checkpoints {
+		if key.namespace == namespace && key.eventHubName == eventHubName && key.consumerGroup == consumerGroup {
+			result = append(result, checkpoint)
+		}
+	}
+	return result, nil
+}
+
+// ListOwnership implements azeventhubs.CheckpointStore.
+func (s *InMemoryCheckpointStore) ListOwnership(ctx context.Context, namespace string, eventHubName string, consumerGroup string, options *azeventhubs.ListOwnershipOptions) ([]azeventhubs.Ownership, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]azeventhubs.Ownership, 0)
+	for key, ownership := range s.ownerships {
+		if key.namespace == namespace && key.eventHubName == eventHubName && key.consumerGroup == consumerGroup {
+			result = append(result, ownership)
+		}
+	}
+	return result, nil
+}
+
+// UpdateCheckpoint implements azeventhubs.CheckpointStore.
+func (s *InMemoryCheckpointStore) UpdateCheckpoint(ctx context.Context, checkpoint azeventhubs.Checkpoint, options *azeventhubs.UpdateCheckpointOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := keyFor(checkpoint.FullyQualifiedNamespace, checkpoint.EventHubName, checkpoint.ConsumerGroup, checkpoint.PartitionID)
+	s.checkpoints[key] = checkpoint
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+)
+
+func TestInMemoryCheckpointStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryCheckpointStore()
+
+	ownership := azeventhubs.Ownership{
+		FullyQualifiedNamespace: "ns.servicebus.windows.net",
+		EventHubName:            "orders",
+		ConsumerGroup:           "$Default",
+		PartitionID:             "0",
+		OwnerID:                 "owner-1",
+	}
+
+	claimed, err := store.ClaimOwnership(ctx, []azeventhubs.Ownership{ownership}, nil)
+	if err != nil {
+		t.Fatalf("ClaimOwnership returned error: %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].OwnerID != "owner-1" {
+		t.Fatalf("ClaimOwnership returned %+v, want the submitted ownership", claimed)
+	}
+
+	listedOwnership, err := store.ListOwnership(ctx, ownership.FullyQualifiedNamespace, ownership.EventHubName, ownership.ConsumerGroup, nil)
+	if err != nil {
+		t.Fatalf("ListOwnership returned error: %v", err)
+	}
+	if len(listedOwnership) != 1 || listedOwnership[0].PartitionID != "0" {
+		t.Fatalf("ListOwnership returned %+v, want the claimed ownership", listedOwnership)
+	}
+
+	offset := "100"
+	checkpoint := azeventhubs.Checkpoint{
+		FullyQualifiedNamespace: ownership.FullyQualifiedNamespace,
+		EventHubName:            ownership.EventHubName,
+		ConsumerGroup:           ownership.ConsumerGroup,
+		PartitionID:             ownership.PartitionID,
+		Offset:                  &offset,
+	}
+
+	if err := store.UpdateCheckpoint(ctx, checkpoint, nil); err != nil {
+		t.Fatalf("UpdateCheckpoint returned error: %v", err)
+	}
+
+	listedCheckpoints, err := store.ListCheckpoints(ctx, ownership.FullyQualifiedNamespace, ownership.EventHubName, ownership.ConsumerGroup, nil)
+	if err != nil {
+		t.Fatalf("ListCheckpoints returned error: %v", err)
+	}
+	if len(listedCheckpoints) != 1 || listedCheckpoints[0].Offset == nil || *listedCheckpoints[0].Offset != "100" {
+		t.Fatalf("ListCheckpoints returned %+v, want the updated checkpoint", listedCheckpoints)
+	}
+}
+
+func TestInMemoryCheckpointStoreScopesByConsumerGroup(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryCheckpointStore()
+
+	offset := "1"
+	for _, consumerGroup := range []string{"$Default", "fraud-detection"} {
+		checkpoint := azeventhubs.Checkpoint{
+			FullyQualifiedNamespace: "ns.servicebus.windows.net",
+			EventHubName:            "orders",
+			ConsumerGroup:           consumerGroup,
+			PartitionID:             "0",
+			Offset:                  &offset,
+		}
+		if err := store.UpdateCheckpoint(ctx, checkpoint, nil); err != nil {
+			t.Fatalf("UpdateCheckpoint(%s) returned error: %v", consumerGroup, err)
+		}
+	}
+
+	checkpoints, err := store.ListCheckpoints(ctx, "ns.servicebus.windows.net", "orders", "$Default", nil)
+	if err != nil {
+		t.Fatalf("ListCheckpoints returned error: %v", err)
+	}
+	if len(checkpoints) != 1 {
+		t.Fatalf("ListCheckpoints returned %d checkpoints, want 1 scoped to the $Default consumer group", len(checkpoints))
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by SendEvent when the per-eventhub circuit
+// breaker is open and the call is rejected without attempting a send.
+var ErrCircuitOpen = errors.New("eventhub: circuit breaker open")
+
+// CircuitBreakerConfig configures the Hystrix-style circuit breaker guarding
+// SendEvent for a given event hub.
+type CircuitBreakerConfig struct {
+	FailureThreshold    int           // consecutive failures before the breaker opens (defaults to 5)
+	OpenDuration        time.Duration // how long the breaker stays open before allowing a half-open probe (defaults to 30s)
+	HalfOpenMaxAttempts int           // concurrent probe sends allowed while half-open (defaults to 1)
+}
+
+func (c CircuitBreakerConfig) withDefaults() CircuitBreakerConfig {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = 5
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = 30 * time.Second
+	}
+	if c.HalfOpenMaxAttempts <= 0 {
+		c.HalfOpenMaxAttempts = 1
+	}
+	return c
+}
+
+type circuitState int32
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker is a per-eventhub Hystrix-style breaker: it opens after
+// FailureThreshold consecutive failures, rejects calls for OpenDuration, then
+// allows a limited number of half-open probes before fully closing again or
+// re-opening on the first probe failure.
+type circuitBreaker struct {
+	config       CircuitBreakerConfig
+	eventHubName string
+
+	mu               sync.Mutex
+	state            circuitState
+	consecutiveFails int
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig, eventHubName string) *circuitBreaker {
+	return &circuitBreaker{
+		config:       config.withDefaults(),
+		eventHubName: eventHubName,
+	}
+}
+
+// circuitStateValue maps a circuitState to the eventhub.circuit.state gauge
+// value documented in newSendMetrics (0=closed, 1=half-open, 2=open). It
+// exists so the gauge's value doesn't silently track circuitState's
+// iota ordering, which numbers open before half-open.
+func circuitStateValue(state circuitState) int64 {
+	switch state {
+	case circuitOpen:
+		return 2
+	case circuitHalfOpen:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// currentState reports the breaker's live state, for the
+// eventhub.circuit.state observable gauge to sample.
+func (b *circuitBreaker) currentState() circuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow reports whether a call may proceed, transitioning the breaker from
+// open to half-open once the cooldown period has elapsed.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if time.Since(b.openedAt) < b.config.OpenDuration {
+			return false
+		}
+		b.setStateLocked(circuitHalfOpen)
+		b.halfOpenInFlight = 1
+		return true
+	case circuitHalfOpen:
+		if b.halfOpenInFlight >= b.config.HalfOpenMaxAttempts {
+			return false
+		}
+		b.halfOpenInFlight++
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFails = 0
+	b.halfOpenInFlight = 0
+	if b.state != circuitClosed {
+		b.setStateLocked(circuitClosed)
+	}
+}
+
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == circuitHalfOpen {
+		b.halfOpenInFlight = 0
+		b.openedAt = time.Now()
+		b.setStateLocked(circuitOpen)
+		return
+	}
+
+	b.consecutiveFails++
+	if b.consecutiveFails >= b.config.FailureThreshold {
+		b.openedAt = time.Now()
+		b.setStateLocked(circuitOpen)
+	}
+}
+
+func (b *circuitBreaker) setStateLocked(state circuitState) {
+	b.state = state
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitStateValueMatchesDocumentedGaugeLegend(t *testing.T) {
+	tests := []struct {
+		state circuitState
+		want  int64
+	}{
+		{circuitClosed, 0},
+		{circuitHalfOpen, 1},
+		{circuitOpen, 2},
+	}
+
+	for _, tt := range tests {
+		if got := circuitStateValue(tt.state); got != tt.want {
+			t.Errorf("circuitStateValue(%v) = %d, want %d", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestCircuitBreakerOpensAfterThreshold(t *testing.T) {
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 3, OpenDuration: time.Hour}, "orders")
+
+	for i := 0; i < 2; i++ {
+		if !breaker.allow() {
+			t.Fatalf("allow() = false before the failure threshold was reached")
+		}
+		breaker.recordFailure()
+	}
+	if breaker.currentState() != circuitClosed {
+		t.Fatalf("state = %v after 2 failures, want closed (threshold is 3)", breaker.currentState())
+	}
+
+	breaker.recordFailure()
+	if breaker.currentState() != circuitOpen {
+		t.Fatalf("state = %v after 3 failures, want open", breaker.currentState())
+	}
+	if breaker.allow() {
+		t.Fatalf("allow() = true while circuit is open")
+	}
+}
+
+func TestCircuitBreakerHalfOpenRecovers(t *testing.T) {
+	breaker := newCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 1,
+		OpenDuration:     time.Millisecond,
+	}, "orders")
+
+	breaker.allow()
+	breaker.recordFailure()
+	if breaker.currentState() != circuitOpen {
+		t.Fatalf("state = %v, want open", breaker.currentState())
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if !breaker.allow() {
+		t.Fatalf("allow() = false once OpenDuration elapsed, want a half-open probe")
+	}
+	if breaker.currentState() != circuitHalfOpen {
+		t.Fatalf("state = %v after the cooldown, want half-open", breaker.currentState())
+	}
+
+	breaker.recordSuccess()
+	if breaker.currentState() != circuitClosed {
+		t.Fatalf("state = %v after a successful probe, want closed", breaker.currentState())
+	}
+}
+
+func TestCircuitBreakerHalfOpenFailureReopens(t *testing.T) {
+	breaker := newCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 1,
+		OpenDuration:     time.Millisecond,
+	}, "orders")
+
+	breaker.allow()
+	breaker.recordFailure()
+	time.Sleep(5 * time.Millisecond)
+
+	if !breaker.allow() {
+		t.Fatalf("allow() = false once OpenDuration elapsed, want a half-open probe")
+	}
+
+	breaker.recordFailure()
+	if breaker.currentState() != circuitOpen {
+		t.Fatalf("state = %v after a failed half-open probe, want open", breaker.currentState())
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CloudEvent is a CloudEvents 1.0 structured-mode JSON envelope.
+type CloudEvent struct {
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	SpecVersion     string          `json:"specversion"`
+	Time            string          `json:"time,omitempty"`
+	Subject         string          `json:"subject,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	TraceParent     string          `json:"traceparent,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// CloudEventOptions supplies the CloudEvents attributes SendCloudEvent can't
+// derive on its own. ID defaults to a new UUID and DataContentType defaults
+// to "application/json" when left empty.
+type CloudEventOptions struct {
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	DataContentType string
+}
+
+// SendCloudEvent wraps data in a CloudEvents 1.0 structured-mode JSON
+// envelope, filling in id (when opts.ID is empty) and time, deriving
+// traceparent from the current OTel span context, and sends the envelope as a single event via SendEvent.
+func (p *EventHubProducer) SendCloudEvent(ctx context.Context, opts CloudEventOptions, data []byte) error {
+	body, err := json.Marshal(newCloudEvent(ctx, opts, data))
+	if err != nil {
+		return fmt.Errorf("failed to marshal CloudEvents envelope: %v", err)
+	}
+
+	return p.SendEvent(ctx, body)
+}
+
+func newCloudEvent(ctx context.Context, opts CloudEventOptions, data []byte) CloudEvent {
+	id := opts.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	contentType := opts.DataContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+
+	return CloudEvent{
+		ID:              id,
+		Source:          opts.Source,
+		Type:            opts.Type,
+		SpecVersion:     "1.0",
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		Subject:         opts.Subject,
+		DataContentType: contentType,
+		TraceParent:     traceParentFromContext(ctx),
+		Data:            json.RawMessage(data),
+	}
+}
+
+func traceParentFromContext(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+
+	flags := "00"
+	if sc.IsSampled() {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), flags)
+}
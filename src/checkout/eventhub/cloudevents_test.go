@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewCloudEventDefaults(t *testing.T) {
+	event := newCloudEvent(context.Background(), CloudEventOptions{Source: "checkout", Type: "order.placed"}, []byte(`{"orderId":"1"}`))
+
+	if event.ID == "" {
+		t.Error("ID defaulted to empty, want a generated UUID")
+	}
+	if event.SpecVersion != "1.0" {
+		t.Errorf("SpecVersion = %q, want 1.0", event.SpecVersion)
+	}
+	if event.DataContentType != "application/json" {
+		t.Errorf("DataContentType = %q, want application/json", event.DataContentType)
+	}
+	if event.Time == "" {
+		t.Error("Time was not populated")
+	}
+	if string(event.Data) != `{"orderId":"1"}` {
+		t.Errorf("Data = %s, want the raw input bytes", event.Data)
+	}
+	if event.TraceParent != "" {
+		t.Errorf("TraceParent = %q, want empty for a context with no active span", event.TraceParent)
+	}
+}
+
+func TestNewCloudEventExplicitOptions(t *testing.T) {
+	opts := CloudEventOptions{
+		ID:              "fixed-id",
+		Source:          "checkout",
+		Type:            "order.placed",
+		Subject:         "order-42",
+		DataContentType: "application/avro",
+	}
+
+	event := newCloudEvent(context.Background(), opts, []byte("payload"))
+
+	if event.ID != "fixed-id" {
+		t.Errorf("ID = %q, want fixed-id (explicit ID should not be overwritten)", event.ID)
+	}
+	if event.Subject != "order-42" {
+		t.Errorf("Subject = %q, want order-42", event.Subject)
+	}
+	if event.DataContentType != "application/avro" {
+		t.Errorf("DataContentType = %q, want application/avro", event.DataContentType)
+	}
+}
+
+func TestTraceParentFromContext(t *testing.T) {
+	if got := traceParentFromContext(context.Background()); got != "" {
+		t.Errorf("traceParentFromContext(empty context) = %q, want empty", got)
+	}
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatalf("TraceIDFromHex: %v", err)
+	}
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatalf("SpanIDFromHex: %v", err)
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	if got := traceParentFromContext(ctx); got != want {
+		t.Errorf("traceParentFromContext(sampled span context) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/open-telemetry/opentelemetry-demo/src/checkout/eventhub")
+
+// HandlerFunc processes a single event received from a partition. Returning
+// an error skips the checkpoint update for the batch containing it; the
+// partition keeps receiving, and the next batch that succeeds advances the
+// checkpoint past the failed one.
+type HandlerFunc func(ctx context.Context, event *azeventhubs.ReceivedEventData) error
+
+// StartPosition selects where a consumer starts reading a partition when it
+// has no existing checkpoint.
+type StartPosition string
+
+const (
+	StartPositionEarliest           StartPosition = "earliest"
+	StartPositionLatest             StartPosition = "latest"
+	StartPositionFromOffset         StartPosition = "from-offset"
+	StartPositionFromSequenceNumber StartPosition = "from-sequence-number"
+)
+
+// EventHubConsumerConfig holds configuration for an EventHub consumer.
+type EventHubConsumerConfig struct {
+	NamespaceName     string // EventHub namespace name (without .servicebus.windows.net)
+	EventHubName      string // EventHub entity name
+	ConnectionString  string // EventHub connection string (optional, for connection string auth)
+	ConsumerGroup     string // Consumer group name (defaults to the $Default consumer group)
+
+	// StorageContainerURL is the Azure Blob container used to persist
+	// checkpoints/ownership so multiple consumer instances can share
+	// partitions. When empty, an in-memory store is used instead, which is
+	// only suitable for a single local/dev instance.
+	StorageContainerURL string
+
+	StartPosition       StartPosition // Where to start reading a partition with no checkpoint (defaults to StartPositionLatest)
+	StartOffset         string        // Offset to start from when StartPosition is StartPositionFromOffset
+	StartSequenceNumber int64         // Sequence number to start from when StartPosition is StartPositionFromSequenceNumber
+}
+
+// EventHubConsumer wraps the Azure EventHub ConsumerClient and Processor to
+// dispatch events per-partition with checkpointing, mirroring EventHubProducer.
+type EventHubConsumer struct {
+	client        *azeventhubs.ConsumerClient
+	processor     *azeventhubs.Processor
+	logger        *slog.Logger
+	eventHubName  string
+	consumerGroup string
+	handler       HandlerFunc
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// CreateEventHubConsumer creates a new EventHub consumer using connection
+// string or managed identity authentication, mirroring CreateEventHubProducer.
+// Received events are dispatched to handler from one goroutine per claimed
+// partition, so handler may run concurrently across partitions, with the
+// checkpoint advanced after each batch handler succeeds.
+func CreateEventHubConsumer(config EventHubConsumerConfig, handler HandlerFunc, logger *slog.Logger) (*EventHubConsumer, error) {
+	if config.NamespaceName == "" && config.ConnectionString == "" {
+		return nil, fmt.Errorf("EventHub namespace name is required")
+	}
+
+	if config.EventHubName == "" {
+		config.EventHubName = EventHubName // Use default if not specified
+	}
+
+	if config.ConsumerGroup == "" {
+		config.ConsumerGroup = azeventhubs.DefaultConsumerGroup
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("handler is required")
+	}
+
+	var client *azeventhubs.ConsumerClient
+	var err error
+
+	if config.ConnectionString != "" {
+		logger.Info("Using EventHub connection string authentication")
+		client, err = azeventhubs.NewConsumerClientFromConnectionString(config.ConnectionString, config.EventHubName, config.ConsumerGroup, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EventHub consumer client with connection string: %v", err)
+		}
+	} else {
+		logger.Info("Using DefaultAzureCredential authentication")
+		cred, credErr := azidentity.NewDefaultAzureCredential(nil)
+		if credErr != nil {
+			return nil, fmt.Errorf("failed to create Azure credential: %v", credErr)
+		}
+
+		fullyQualifiedNamespace := fmt.Sprintf("%s.servicebus.windows.net", config.NamespaceName)
+
+		client, err = azeventhubs.NewConsumerClient(fullyQualifiedNamespace, config.EventHubName, config.ConsumerGroup, cred, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EventHub consumer client: %v", err)
+		}
+	}
+
+	checkpointStore, err := newCheckpointStore(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	startPosition, err := startPositionFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	processor, err := azeventhubs.NewProcessor(client, checkpointStore, &azeventhubs.ProcessorOptions{
+		StartPositions: azeventhubs.ProcessorStartPositions{
+			Default: startPosition,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EventHub processor: %v", err)
+	}
+
+	logger.Info("EventHub consumer client created successfully",
+		slog.String("eventhub", config.EventHubName),
+		slog.String("consumer_group", config.ConsumerGroup))
+
+	return &EventHubConsumer{
+		client:        client,
+		processor:     processor,
+		logger:        logger,
+		eventHubName:  config.EventHubName,
+		consumerGroup: config.ConsumerGroup,
+		handler:       handler,
+	}, nil
+}
+
+func startPositionFor(config EventHubConsumerConfig) (azeventhubs.StartPosition, error) {
+	switch config.StartPosition {
+	case "", StartPositionLatest:
+		return azeventhubs.StartPosition{Latest: to.Ptr(true)}, nil
+	case StartPositionEarliest:
+		return azeventhubs.StartPosition{Earliest: to.Ptr(true)}, nil
+	case StartPositionFromOffset:
+		if config.StartOffset == "" {
+			return azeventhubs.StartPosition{}, fmt.Errorf("StartOffset is required when StartPosition is %q", StartPositionFromOffset)
+		}
+		return azeventhubs.StartPosition{Offset: to.Ptr(config.StartOffset)}, nil
+	case StartPositionFromSequenceNumber:
+		return azeventhubs.StartPosition{SequenceNumber: to.Ptr(config.StartSequenceNumber)}, nil
+	default:
+		return azeventhubs.StartPosition{}, fmt.Errorf("unknown start position %q", config.StartPosition)
+	}
+}
+
+// Run starts the processor and blocks, dispatching claimed partitions to the
+// handler until ctx is canceled or Close is called.
+func (c *EventHubConsumer) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := c.processor.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			c.logger.Error("EventHub processor stopped with error", slog.Any("error", err))
+		}
+	}()
+
+	for {
+		partitionClient := c.processor.NextPartitionClient(ctx)
+		if partitionClient == nil {
+			break // processor is shutting down
+		}
+
+		c.wg.Add(1)
+		go func(pc *azeventhubs.ProcessorPartitionClient) {
+			defer c.wg.Done()
+			c.dispatch(ctx, pc)
+		}(partitionClient)
+	}
+
+	c.wg.Wait()
+	return ctx.Err()
+}
+
+// dispatch receives and processes batches of events for a single partition
+// until ctx is canceled or ownership of the partition is lost.
+func (c *EventHubConsumer) dispatch(ctx context.Context, partitionClient *azeventhubs.ProcessorPartitionClient) {
+	defer partitionClient.Close(context.Background())
+
+	for {
+		receiveCtx, receiveCancel := context.WithTimeout(ctx, time.Minute)
+		events, err := partitionClient.ReceiveEvents(receiveCtx, 100, nil)
+		receiveCancel()
+
+		if err != nil {
+			var ehErr *azeventhubs.Error
+			if errors.As(err, &ehErr) && ehErr.Code == azeventhubs.ErrorCodeOwnershipLost {
+				return
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				continue // no events before the receive timeout, poll again
+			}
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			c.logger.Error("failed to receive events",
+				slog.String("partition_id", partitionClient.PartitionID()), slog.Any("error", err))
+			continue
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		if err := c.processBatch(ctx, partitionClient, events); err != nil {
+			c.logger.Error("failed to process event batch",
+				slog.String("partition_id", partitionClient.PartitionID()), slog.Any("error", err))
+		}
+	}
+}
+
+// producerLinks extracts the producer's trace context (injected into
+// EventData.Properties, e.g. by messaging.EventHubPublisher) from each event
+// and returns it as a span Link, so the batch's consumer span links back to
+// every producer span it contains instead of starting a disconnected trace.
+func producerLinks(events []*azeventhubs.ReceivedEventData) []trace.Link {
+	links := make([]trace.Link, 0, len(events))
+	for _, event := range events {
+		producerCtx := otel.GetTextMapPropagator().Extract(context.Background(), eventPropertiesCarrier(event.Properties))
+		if sc := trace.SpanContextFromContext(producerCtx); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	return links
+}
+
+// eventPropertiesCarrier adapts an EventData's Properties map to
+// propagation.TextMapCarrier so a producer's injected trace context can be
+// extracted from it.
+type eventPropertiesCarrier map[string]any
+
+func (c eventPropertiesCarrier) Get(key string) string {
+	value, ok := c[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+func (c eventPropertiesCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c eventPropertiesCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (c *EventHubConsumer) processBatch(ctx context.Context, partitionClient *azeventhubs.ProcessorPartitionClient, events []*azeventhubs.ReceivedEventData) error {
+	ctx, span := tracer.Start(ctx, "eventhub.consume",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(producerLinks(events)...),
+		trace.WithAttributes(
+			semconv.MessagingSystem("eventhubs"),
+			semconv.MessagingDestinationName(c.eventHubName),
+			attribute.String("messaging.eventhubs.consumer_group", c.consumerGroup),
+			attribute.String("messaging.eventhubs.partition_id", partitionClient.PartitionID()),
+			attribute.Int("messaging.batch.message_count", len(events)),
+		))
+	defer span.End()
+
+	for _, event := range events {
+		if err := c.handler(ctx, event); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "handler failed")
+			return fmt.Errorf("handler failed: %v", err)
+		}
+	}
+
+	if err := partitionClient.UpdateCheckpoint(ctx, events[len(events)-1], nil); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "checkpoint update failed")
+		return fmt.Errorf("failed to update checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+// Close stops dispatching, waits for in-flight batches to finish, and closes
+// the underlying consumer client.
+func (c *EventHubConsumer) Close(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+
+	if c.client != nil {
+		return c.client.Close(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+)
+
+// DeadLetterEvent captures a send that permanently failed under RetryConfig's
+// policy (a terminal error, or retries that ended without success), for a
+// DeadLetterSink to persist.
+type DeadLetterEvent struct {
+	EventHubName string
+	Headers      map[string]any
+	Body         []byte
+	LastError    string
+	FailedAt     time.Time
+}
+
+// DeadLetterSink receives events that exhausted SendEvent's retry policy.
+type DeadLetterSink interface {
+	Send(ctx context.Context, event DeadLetterEvent) error
+}
+
+// FileDeadLetterSink appends dead-lettered events as JSON lines to a local
+// file, intended for local/dev use.
+type FileDeadLetterSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileDeadLetterSink opens (creating if necessary) path for appending
+// dead-lettered events.
+func NewFileDeadLetterSink(path string) (*FileDeadLetterSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dead-letter file: %v", err)
+	}
+	return &FileDeadLetterSink{file: file}, nil
+}
+
+// Send implements DeadLetterSink.
+func (s *FileDeadLetterSink) Send(ctx context.Context, event DeadLetterEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter event: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write dead-letter event: %v", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (s *FileDeadLetterSink) Close() error {
+	return s.file.Close()
+}
+
+// BlobDeadLetterSink uploads one blob per dead-lettered event to an Azure
+// Blob container, for durable storage shared across consumer instances.
+type BlobDeadLetterSink struct {
+	containerClient *container.Client
+}
+
+// NewBlobDeadLetterSink creates a BlobDeadLetterSink backed by containerURL.
+func NewBlobDeadLetterSink(containerURL string, cred azcore.TokenCredential) (*BlobDeadLetterSink, error) {
+	client, err := container.NewClient(containerURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dead-letter blob container client: %v", err)
+	}
+	return &BlobDeadLetterSink{containerClient: client}, nil
+}
+
+// Send implements DeadLetterSink.
+func (s *BlobDeadLetterSink) Send(ctx context.Context, event DeadLetterEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter event: %v", err)
+	}
+
+	blobName := fmt.Sprintf("%s/%d.json", event.EventHubName, event.FailedAt.UnixNano())
+	blockBlobClient := s.containerClient.NewBlockBlobClient(blobName)
+	if _, err := blockBlobClient.UploadBuffer(ctx, data, nil); err != nil {
+		return fmt.Errorf("failed to upload dead-letter blob: %v", err)
+	}
+	return nil
+}
+
+// EventHubDeadLetterSink republishes dead-lettered events, along with the
+// original headers and the last error, to another EventHubProducer.
+type EventHubDeadLetterSink struct {
+	producer *EventHubProducer
+}
+
+// NewEventHubDeadLetterSink creates an EventHubDeadLetterSink that republishes
+// through producer (typically pointed at a dedicated dead-letter event hub).
+func NewEventHubDeadLetterSink(producer *EventHubProducer) *EventHubDeadLetterSink {
+	return &EventHubDeadLetterSink{producer: producer}
+}
+
+// Send implements DeadLetterSink.
+func (s *EventHubDeadLetterSink) Send(ctx context.Context, event DeadLetterEvent) error {
+	properties := map[string]any{
+		"dead_letter_source_hub": event.EventHubName,
+		"dead_letter_reason":     event.LastError,
+		"dead_letter_failed_at":  event.FailedAt.Format(time.RFC3339),
+	}
+	for k, v := range event.Headers {
+		properties[k] = v
+	}
+
+	return s.producer.SendEvents(ctx, []Event{{Body: event.Body, Properties: properties}})
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// sendMetrics holds the OTel instruments emitted by SendEvent's retry,
+// circuit breaker, and dead-letter policy so operators can alert on it.
+type sendMetrics struct {
+	retries      metric.Int64Counter
+	deadLettered metric.Int64Counter
+}
+
+// newSendMetrics creates the retry/dead-letter counters and registers an
+// eventhub.circuit.state gauge sampling breaker's live state (0=closed,
+// 1=half-open, 2=open) so an alert like "circuit == open" is expressible —
+// a monotonic transition counter can't express current state.
+func newSendMetrics(eventHubName string, breaker *circuitBreaker) (*sendMetrics, error) {
+	meter := otel.Meter("github.com/open-telemetry/opentelemetry-demo/src/checkout/eventhub")
+
+	retries, err := meter.Int64Counter("eventhub.send.retries",
+		metric.WithDescription("Number of SendEvent retries due to transient errors"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create eventhub.send.retries counter: %v", err)
+	}
+
+	deadLettered, err := meter.Int64Counter("eventhub.send.deadlettered",
+		metric.WithDescription("Number of events sent to a dead-letter sink after a permanently failed send"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create eventhub.send.deadlettered counter: %v", err)
+	}
+
+	_, err = meter.Int64ObservableGauge("eventhub.circuit.state",
+		metric.WithDescription("Current circuit breaker state: 0=closed, 1=half-open, 2=open"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(circuitStateValue(breaker.currentState()), metric.WithAttributes(attribute.String("eventhub", eventHubName)))
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create eventhub.circuit.state gauge: %v", err)
+	}
+
+	return &sendMetrics{retries: retries, deadLettered: deadLettered}, nil
+}
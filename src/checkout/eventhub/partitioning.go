@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+)
+
+// PartitionResolution is the outcome of a PartitionKeyStrategy: at most one
+// of Key or PartitionID is set, matching EventDataBatchOptions' mutually
+// exclusive PartitionKey/PartitionID fields. A zero value leaves the event
+// unpartitioned, letting Event Hubs assign a partition.
+type PartitionResolution struct {
+	Key         *string
+	PartitionID *string
+}
+
+// PartitionKeyStrategy resolves how an event should be routed to a
+// partition, so related events (e.g. the same customer or order ID) land on
+// the same partition, enabling in-order per-key processing on the consumer
+// side. EventHubProducer applies it to events that don't already set
+// PartitionKey/PartitionID.
+type PartitionKeyStrategy interface {
+	Resolve(event Event) PartitionResolution
+}
+
+func (p *EventHubProducer) applyPartitionStrategy(event Event) Event {
+	if event.PartitionKey != nil || event.PartitionID != nil || p.partitionStrategy == nil {
+		return event
+	}
+
+	resolution := p.partitionStrategy.Resolve(event)
+	event.PartitionKey = resolution.Key
+	event.PartitionID = resolution.PartitionID
+	return event
+}
+
+// Fixed always resolves to the same partition key.
+func Fixed(key string) PartitionKeyStrategy {
+	return fixedStrategy{key: key}
+}
+
+type fixedStrategy struct{ key string }
+
+func (s fixedStrategy) Resolve(Event) PartitionResolution {
+	key := s.key
+	return PartitionResolution{Key: &key}
+}
+
+// FromField extracts the named top-level field from the event body (which
+// must be a flat JSON object) and uses its value as the partition key.
+// Events whose body isn't JSON, or that don't have the field, are left
+// unpartitioned.
+func FromField(fieldName string) PartitionKeyStrategy {
+	return fromFieldStrategy{fieldName: fieldName}
+}
+
+type fromFieldStrategy struct{ fieldName string }
+
+func (s fromFieldStrategy) Resolve(event Event) PartitionResolution {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(event.Body, &fields); err != nil {
+		return PartitionResolution{}
+	}
+
+	raw, ok := fields[s.fieldName]
+	if !ok {
+		return PartitionResolution{}
+	}
+
+	var key string
+	if err := json.Unmarshal(raw, &key); err != nil {
+		key = string(raw)
+	}
+
+	return PartitionResolution{Key: &key}
+}
+
+// FromHeader uses the named entry of the event's Properties as the partition
+// key. Events without that property are left unpartitioned.
+func FromHeader(name string) PartitionKeyStrategy {
+	return fromHeaderStrategy{name: name}
+}
+
+type fromHeaderStrategy struct{ name string }
+
+func (s fromHeaderStrategy) Resolve(event Event) PartitionResolution {
+	value, ok := event.Properties[s.name]
+	if !ok {
+		return PartitionResolution{}
+	}
+
+	key := fmt.Sprintf("%v", value)
+	return PartitionResolution{Key: &key}
+}
+
+// Consistent derives a partition key by calling keyFunc, for routing logic
+// that doesn't fit FromField/FromHeader.
+func Consistent(keyFunc func(event Event) string) PartitionKeyStrategy {
+	return consistentStrategy{keyFunc: keyFunc}
+}
+
+type consistentStrategy struct {
+	keyFunc func(event Event) string
+}
+
+func (s consistentStrategy) Resolve(event Event) PartitionResolution {
+	key := s.keyFunc(event)
+	return PartitionResolution{Key: &key}
+}
+
+// RoundRobin cycles through partitionIDs in order, routing each event
+// directly to the next partition via PartitionID. Construct it with the
+// result of EventHubProducer.GetPartitionIDs.
+func RoundRobin(partitionIDs []string) PartitionKeyStrategy {
+	return &roundRobinStrategy{partitionIDs: partitionIDs}
+}
+
+type roundRobinStrategy struct {
+	partitionIDs []string
+	next         uint64
+}
+
+func (s *roundRobinStrategy) Resolve(Event) PartitionResolution {
+	if len(s.partitionIDs) == 0 {
+		return PartitionResolution{}
+	}
+
+	index := atomic.AddUint64(&s.next, 1) - 1
+	partitionID := s.partitionIDs[index%uint64(len(s.partitionIDs))]
+	return PartitionResolution{PartitionID: &partitionID}
+}
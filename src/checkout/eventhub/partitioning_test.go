@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import "testing"
+
+func TestFixedStrategy(t *testing.T) {
+	strategy := Fixed("customer-42")
+
+	resolution := strategy.Resolve(Event{Body: []byte(`{}`)})
+	if resolution.Key == nil || *resolution.Key != "customer-42" {
+		t.Fatalf("Resolve().Key = %v, want customer-42", resolution.Key)
+	}
+	if resolution.PartitionID != nil {
+		t.Fatalf("Resolve().PartitionID = %v, want nil", resolution.PartitionID)
+	}
+}
+
+func TestFromFieldStrategy(t *testing.T) {
+	strategy := FromField("customerId")
+
+	resolution := strategy.Resolve(Event{Body: []byte(`{"customerId":"c-1","orderId":"o-1"}`)})
+	if resolution.Key == nil || *resolution.Key != "c-1" {
+		t.Fatalf("Resolve().Key = %v, want c-1", resolution.Key)
+	}
+
+	missing := strategy.Resolve(Event{Body: []byte(`{"orderId":"o-1"}`)})
+	if missing.Key != nil {
+		t.Fatalf("Resolve().Key = %v for a body missing the field, want nil", missing.Key)
+	}
+
+	invalidJSON := strategy.Resolve(Event{Body: []byte(`not json`)})
+	if invalidJSON.Key != nil {
+		t.Fatalf("Resolve().Key = %v for a non-JSON body, want nil", invalidJSON.Key)
+	}
+}
+
+func TestFromHeaderStrategy(t *testing.T) {
+	strategy := FromHeader("customer-id")
+
+	resolution := strategy.Resolve(Event{Properties: map[string]any{"customer-id": "c-1"}})
+	if resolution.Key == nil || *resolution.Key != "c-1" {
+		t.Fatalf("Resolve().Key = %v, want c-1", resolution.Key)
+	}
+
+	missing := strategy.Resolve(Event{Properties: map[string]any{}})
+	if missing.Key != nil {
+		t.Fatalf("Resolve().Key = %v for an event without the header, want nil", missing.Key)
+	}
+}
+
+func TestConsistentStrategy(t *testing.T) {
+	strategy := Consistent(func(event Event) string { return string(event.Body) })
+
+	resolution := strategy.Resolve(Event{Body: []byte("order-42")})
+	if resolution.Key == nil || *resolution.Key != "order-42" {
+		t.Fatalf("Resolve().Key = %v, want order-42", resolution.Key)
+	}
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	strategy := RoundRobin([]string{"0", "1", "2"})
+
+	want := []string{"0", "1", "2", "0", "1"}
+	for i, w := range want {
+		resolution := strategy.Resolve(Event{})
+		if resolution.PartitionID == nil || *resolution.PartitionID != w {
+			t.Fatalf("Resolve() #%d PartitionID = %v, want %s", i, resolution.PartitionID, w)
+		}
+		if resolution.Key != nil {
+			t.Fatalf("Resolve() #%d Key = %v, want nil", i, resolution.Key)
+		}
+	}
+}
+
+func TestRoundRobinStrategyEmpty(t *testing.T) {
+	strategy := RoundRobin(nil)
+
+	resolution := strategy.Resolve(Event{})
+	if resolution.PartitionID != nil || resolution.Key != nil {
+		t.Fatalf("Resolve() with no partition IDs = %+v, want a zero-value resolution", resolution)
+	}
+}
@@ -18,16 +18,24 @@ var (
 
 // EventHubProducer wraps the Azure EventHub producer client
 type EventHubProducer struct {
-	client    *azeventhubs.ProducerClient
-	logger    *slog.Logger
+	client       *azeventhubs.ProducerClient
+	logger       *slog.Logger
 	eventHubName string
+
+	retryConfig       RetryConfig
+	breaker           *circuitBreaker
+	deadLetter        DeadLetterSink
+	metrics           *sendMetrics
+	partitionStrategy PartitionKeyStrategy
 }
 
 // EventHubConfig holds configuration for EventHub connection
 type EventHubConfig struct {
-	NamespaceName     string // EventHub namespace name (without .servicebus.windows.net)
-	EventHubName      string // EventHub entity name
-	ConnectionString  string // EventHub connection string (optional, for connection string auth)
+	NamespaceName     string               // EventHub namespace name (without .servicebus.windows.net)
+	EventHubName      string               // EventHub entity name
+	ConnectionString  string               // EventHub connection string (optional, for connection string auth)
+	Retry             RetryConfig          // Retry/circuit-breaker/dead-letter policy applied to SendEvent
+	PartitionStrategy PartitionKeyStrategy // optional; resolves PartitionKey/PartitionID for events that don't already set one
 }
 
 // CreateEventHubProducer creates a new EventHub producer using connection string or managed identity
@@ -69,49 +77,88 @@ func CreateEventHubProducer(config EventHubConfig, logger *slog.Logger) (*EventH
 		}
 	}
 
-	logger.Info("EventHub producer client created successfully", 
+	logger.Info("EventHub producer client created successfully",
 		slog.String("eventhub", config.EventHubName))
 
+	retryConfig := config.Retry.withDefaults()
+	breaker := newCircuitBreaker(retryConfig.CircuitBreaker, config.EventHubName)
+
+	metrics, err := newSendMetrics(config.EventHubName, breaker)
+	if err != nil {
+		return nil, err
+	}
+
 	return &EventHubProducer{
-		client:    client,
-		logger:    logger,
-		eventHubName: config.EventHubName,
+		client:            client,
+		logger:            logger,
+		eventHubName:      config.EventHubName,
+		retryConfig:       retryConfig,
+		breaker:           breaker,
+		deadLetter:        retryConfig.DeadLetter,
+		metrics:           metrics,
+		partitionStrategy: config.PartitionStrategy,
 	}, nil
 }
 
-// SendEvent sends a message to EventHub
+// SendEvent sends a message to EventHub, retrying transient failures with
+// backoff, tripping a per-eventhub circuit breaker on sustained failure, and
+// dead-lettering the message if every attempt is exhausted. See RetryConfig.
 func (p *EventHubProducer) SendEvent(ctx context.Context, message []byte) error {
 	if p.client == nil {
 		return fmt.Errorf("EventHub client is not initialized")
 	}
 
-	// Create EventHub event data
-	eventData := &azeventhubs.EventData{
-		Body: message,
-	}
+	return p.sendWithPolicy(ctx, nil, message, func(ctx context.Context) error {
+		// Create EventHub event data
+		eventData := &azeventhubs.EventData{
+			Body: message,
+		}
 
-	// Create a batch with the single event
-	batch, err := p.client.NewEventDataBatch(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create event batch: %v", err)
-	}
+		// Create a batch with the single event
+		batch, err := p.client.NewEventDataBatch(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create event batch: %v", err)
+		}
+
+		err = batch.AddEventData(eventData, nil)
+		if err != nil {
+			return fmt.Errorf("failed to add event to batch: %v", err)
+		}
+
+		// Send the batch
+		err = p.client.SendEventDataBatch(ctx, batch, nil)
+		if err != nil {
+			return fmt.Errorf("failed to send event batch: %v", err)
+		}
+
+		p.logger.Info("Event sent successfully to EventHub",
+			slog.String("eventhub", p.eventHubName),
+			slog.Int("message_size", len(message)))
 
-	err = batch.AddEventData(eventData, nil)
+		return nil
+	})
+}
+
+// GetPartitionIDs returns the event hub's partition IDs, so callers (and
+// health checks) can see how many partitions exist, e.g. to size a
+// RoundRobin strategy or validate that a PartitionKeyStrategy actually
+// distributes load.
+func (p *EventHubProducer) GetPartitionIDs(ctx context.Context) ([]string, error) {
+	properties, err := p.client.GetEventHubProperties(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to add event to batch: %v", err)
+		return nil, fmt.Errorf("failed to get EventHub properties: %v", err)
 	}
+	return properties.PartitionIDs, nil
+}
 
-	// Send the batch
-	err = p.client.SendEventDataBatch(ctx, batch, nil)
+// GetPartitionProperties returns partition-level metadata for partitionID,
+// including the last-enqueued sequence number.
+func (p *EventHubProducer) GetPartitionProperties(ctx context.Context, partitionID string) (azeventhubs.PartitionProperties, error) {
+	properties, err := p.client.GetPartitionProperties(ctx, partitionID, nil)
 	if err != nil {
-		return fmt.Errorf("failed to send event batch: %v", err)
+		return azeventhubs.PartitionProperties{}, fmt.Errorf("failed to get partition properties for partition %q: %v", partitionID, err)
 	}
-
-	p.logger.Info("Event sent successfully to EventHub",
-		slog.String("eventhub", p.eventHubName),
-		slog.Int("message_size", len(message)))
-
-	return nil
+	return properties, nil
 }
 
 // Close closes the EventHub producer client
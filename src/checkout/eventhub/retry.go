@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RetryConfig configures the retry, circuit breaker, and dead-letter policy
+// wrapping EventHubProducer.SendEvent.
+type RetryConfig struct {
+	MaxAttempts       int           // total send attempts, including the first (defaults to 3)
+	InitialBackoff    time.Duration // backoff before the first retry (defaults to 200ms)
+	MaxBackoff        time.Duration // backoff ceiling (defaults to 5s)
+	BackoffMultiplier float64       // multiplier applied to the backoff after each retry (defaults to 2)
+	Jitter            float64       // fraction of the backoff randomized, e.g. 0.2 for +/-20% (defaults to 0.2)
+
+	CircuitBreaker CircuitBreakerConfig
+	DeadLetter     DeadLetterSink // optional; permanently-failed sends are dropped if nil
+}
+
+func (c RetryConfig) withDefaults() RetryConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 3
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = 200 * time.Millisecond
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 5 * time.Second
+	}
+	if c.BackoffMultiplier <= 0 {
+		c.BackoffMultiplier = 2
+	}
+	if c.Jitter <= 0 {
+		c.Jitter = 0.2
+	}
+	return c
+}
+
+// isRetryable classifies an error returned from the EventHub send path as
+// transient (throttling, connection resets, entity-busy) or terminal.
+func isRetryable(err error) bool {
+	var ehErr *azeventhubs.Error
+	if errors.As(err, &ehErr) {
+		switch ehErr.Code {
+		case azeventhubs.ErrorCodeConnectionLost, azeventhubs.ErrorCodeServerBusy, azeventhubs.ErrorCodeResourceLimitExceeded:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// A deadline blowing past during the send itself (as opposed to one hit
+	// while we were already backing off) is worth one more attempt.
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// sendWithPolicy runs send through the circuit breaker and retry policy,
+// dead-lettering the event (via headers/body) once at least one genuine send
+// attempt has been made and the policy gives up: on a terminal error, after
+// the last attempt, when the breaker opens mid-retry, or when ctx is done
+// during backoff. A rejection from an open circuit breaker never
+// reaches send at all, so it is returned as-is without dead-lettering —
+// that's load-shedding, not a permanently failed batch.
+func (p *EventHubProducer) sendWithPolicy(ctx context.Context, headers map[string]any, body []byte, send func(ctx context.Context) error) error {
+	config := p.retryConfig
+
+	var lastErr error
+	backoff := config.InitialBackoff
+	interrupted := false
+	attempted := false
+	attempts := 0
+
+	for attempt := 1; attempt <= config.MaxAttempts && !interrupted; attempt++ {
+		if p.breaker != nil && !p.breaker.allow() {
+			if !attempted {
+				return ErrCircuitOpen
+			}
+			break
+		}
+
+		attempted = true
+		attempts++
+		err := send(ctx)
+		if err == nil {
+			if p.breaker != nil {
+				p.breaker.recordSuccess()
+			}
+			return nil
+		}
+
+		lastErr = err
+		if p.breaker != nil {
+			p.breaker.recordFailure()
+		}
+
+		if !isRetryable(err) || attempt == config.MaxAttempts {
+			break
+		}
+
+		if p.metrics != nil {
+			p.metrics.retries.Add(ctx, 1, metric.WithAttributes(attribute.String("eventhub", p.eventHubName)))
+		}
+
+		select {
+		case <-time.After(jitter(backoff, config.Jitter)):
+		case <-ctx.Done():
+			lastErr = ctx.Err()
+			interrupted = true
+		}
+
+		backoff = time.Duration(float64(backoff) * config.BackoffMultiplier)
+		if backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+
+	if attempted && p.deadLetter != nil {
+		dlErr := p.deadLetter.Send(ctx, DeadLetterEvent{
+			EventHubName: p.eventHubName,
+			Headers:      headers,
+			Body:         body,
+			LastError:    lastErr.Error(),
+			FailedAt:     time.Now(),
+		})
+		if dlErr != nil {
+			p.logger.Error("failed to dead-letter event",
+				slog.Any("send_error", lastErr), slog.Any("deadletter_error", dlErr))
+		} else if p.metrics != nil {
+			p.metrics.deadLettered.Add(ctx, 1, metric.WithAttributes(attribute.String("eventhub", p.eventHubName)))
+		}
+	}
+
+	return fmt.Errorf("failed to send event after %d attempt(s): %v", attempts, lastErr)
+}
+
+func jitter(base time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return base
+	}
+	delta := float64(base) * fraction
+	return base + time.Duration((rand.Float64()*2-1)*delta)
+}
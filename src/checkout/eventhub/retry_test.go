@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connection lost", &azeventhubs.Error{Code: azeventhubs.ErrorCodeConnectionLost}, true},
+		{"server busy", &azeventhubs.Error{Code: azeventhubs.ErrorCodeServerBusy}, true},
+		{"resource limit exceeded", &azeventhubs.Error{Code: azeventhubs.ErrorCodeResourceLimitExceeded}, true},
+		{"ownership lost is terminal", &azeventhubs.Error{Code: azeventhubs.ErrorCodeOwnershipLost}, false},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"unrelated error", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	base := 100 * time.Millisecond
+
+	if got := jitter(base, 0); got != base {
+		t.Errorf("jitter(%v, 0) = %v, want %v (no jitter)", base, got, base)
+	}
+
+	fraction := 0.2
+	delta := float64(base) * fraction
+	min := base - time.Duration(delta)
+	max := base + time.Duration(delta)
+
+	for i := 0; i < 100; i++ {
+		got := jitter(base, fraction)
+		if got < min || got > max {
+			t.Fatalf("jitter(%v, %v) = %v, want within [%v, %v]", base, fraction, got, min, max)
+		}
+	}
+}
+
+type fakeDeadLetterSink struct {
+	events []DeadLetterEvent
+}
+
+func (s *fakeDeadLetterSink) Send(_ context.Context, event DeadLetterEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestSendWithPolicyDeadLettersWhenBreakerTripsMidAttempt(t *testing.T) {
+	sink := &fakeDeadLetterSink{}
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Hour}, "orders")
+
+	producer := &EventHubProducer{
+		eventHubName: "orders",
+		retryConfig: RetryConfig{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			DeadLetter:     sink,
+		}.withDefaults(),
+		breaker:    breaker,
+		deadLetter: sink,
+	}
+
+	err := producer.sendWithPolicy(context.Background(), nil, []byte("body"), func(context.Context) error {
+		return &azeventhubs.Error{Code: azeventhubs.ErrorCodeServerBusy}
+	})
+	if err == nil {
+		t.Fatal("sendWithPolicy returned nil, want an error")
+	}
+	if errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("sendWithPolicy returned ErrCircuitOpen for a genuinely attempted send, want the send failure to be dead-lettered and surfaced")
+	}
+	if len(sink.events) != 1 {
+		t.Fatalf("dead-letter sink got %d events, want 1 (the attempt that failed and tripped the breaker)", len(sink.events))
+	}
+	wantReason := (&azeventhubs.Error{Code: azeventhubs.ErrorCodeServerBusy}).Error()
+	if sink.events[0].LastError != wantReason {
+		t.Fatalf("dead-letter LastError = %q, want %q (the send failure, not the breaker trip)", sink.events[0].LastError, wantReason)
+	}
+}
+
+func TestSendWithPolicyRejectsUnattemptedSendWhenBreakerAlreadyOpen(t *testing.T) {
+	sink := &fakeDeadLetterSink{}
+	breaker := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Hour}, "orders")
+	breaker.recordFailure()
+
+	producer := &EventHubProducer{
+		eventHubName: "orders",
+		retryConfig:  RetryConfig{DeadLetter: sink}.withDefaults(),
+		breaker:      breaker,
+		deadLetter:   sink,
+	}
+
+	calls := 0
+	err := producer.sendWithPolicy(context.Background(), nil, []byte("body"), func(context.Context) error {
+		calls++
+		return nil
+	})
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("sendWithPolicy() = %v, want ErrCircuitOpen", err)
+	}
+	if calls != 0 {
+		t.Fatalf("send was called %d times, want 0 (breaker was already open)", calls)
+	}
+	if len(sink.events) != 0 {
+		t.Fatalf("dead-letter sink got %d events, want 0 (send was never attempted)", len(sink.events))
+	}
+}
+
+func TestRetryConfigWithDefaults(t *testing.T) {
+	config := RetryConfig{}.withDefaults()
+
+	if config.MaxAttempts != 3 {
+		t.Errorf("MaxAttempts = %d, want 3", config.MaxAttempts)
+	}
+	if config.InitialBackoff != 200*time.Millisecond {
+		t.Errorf("InitialBackoff = %v, want 200ms", config.InitialBackoff)
+	}
+	if config.MaxBackoff != 5*time.Second {
+		t.Errorf("MaxBackoff = %v, want 5s", config.MaxBackoff)
+	}
+	if config.BackoffMultiplier != 2 {
+		t.Errorf("BackoffMultiplier = %v, want 2", config.BackoffMultiplier)
+	}
+	if config.Jitter != 0.2 {
+		t.Errorf("Jitter = %v, want 0.2", config.Jitter)
+	}
+
+	explicit := RetryConfig{MaxAttempts: 7}.withDefaults()
+	if explicit.MaxAttempts != 7 {
+		t.Errorf("explicit MaxAttempts overridden: got %d, want 7", explicit.MaxAttempts)
+	}
+}
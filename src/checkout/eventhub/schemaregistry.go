@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azschemaregistry"
+)
+
+// SchemaRegistry resolves the registry-assigned ID for an Avro schema,
+// registering it first if the registry doesn't already have it, mirroring
+// Event Hubs' own schema-registry serializer contract.
+type SchemaRegistry interface {
+	SchemaID(ctx context.Context, subject string, definition string) (string, error)
+}
+
+// AzureSchemaRegistry resolves schema IDs against an Azure Schema Registry
+// group.
+type AzureSchemaRegistry struct {
+	client    *azschemaregistry.Client
+	groupName string
+}
+
+// NewAzureSchemaRegistry creates an AzureSchemaRegistry against groupName in
+// the schema registry namespace fullyQualifiedNamespace.
+func NewAzureSchemaRegistry(fullyQualifiedNamespace, groupName string, cred azcore.TokenCredential) (*AzureSchemaRegistry, error) {
+	client, err := azschemaregistry.NewClient(fullyQualifiedNamespace, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema registry client: %v", err)
+	}
+	return &AzureSchemaRegistry{client: client, groupName: groupName}, nil
+}
+
+// SchemaID implements SchemaRegistry.
+func (r *AzureSchemaRegistry) SchemaID(ctx context.Context, subject string, definition string) (string, error) {
+	getResp, err := r.client.GetSchemaProperties(ctx, r.groupName, subject, azschemaregistry.FormatAvro, definition, nil)
+	if err == nil {
+		return *getResp.ID, nil
+	}
+
+	registerResp, registerErr := r.client.RegisterSchema(ctx, r.groupName, subject, azschemaregistry.FormatAvro, definition, nil)
+	if registerErr != nil {
+		return "", fmt.Errorf("failed to resolve or register Avro schema for subject %q: %v", subject, registerErr)
+	}
+	return *registerResp.ID, nil
+}
+
+// InMemorySchemaRegistry is a SchemaRegistry backed by a process-local map.
+// It assigns sequential IDs to (subject, definition) pairs the first time
+// they're seen and returns the same ID thereafter, for use in tests.
+type InMemorySchemaRegistry struct {
+	mu      sync.Mutex
+	nextID  int
+	schemas map[string]string
+}
+
+// NewInMemorySchemaRegistry creates an empty InMemorySchemaRegistry.
+func NewInMemorySchemaRegistry() *InMemorySchemaRegistry {
+	return &InMemorySchemaRegistry{schemas: map[string]string{}}
+}
+
+// SchemaID implements SchemaRegistry.
+func (r *InMemorySchemaRegistry) SchemaID(ctx context.Context, subject string, definition string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := subject + "\x00" + definition
+	if id, ok := r.schemas[key]; ok {
+		return id, nil
+	}
+
+	r.nextID++
+	id := strconv.Itoa(r.nextID)
+	r.schemas[key] = id
+	return id, nil
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package eventhub
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInMemorySchemaRegistryAssignsSequentialIDs(t *testing.T) {
+	registry := NewInMemorySchemaRegistry()
+	ctx := context.Background()
+
+	id1, err := registry.SchemaID(ctx, "orders", `{"type":"record","name":"Order","fields":[]}`)
+	if err != nil {
+		t.Fatalf("SchemaID returned error: %v", err)
+	}
+
+	id2, err := registry.SchemaID(ctx, "orders", `{"type":"record","name":"OrderV2","fields":[]}`)
+	if err != nil {
+		t.Fatalf("SchemaID returned error: %v", err)
+	}
+
+	if id1 == id2 {
+		t.Fatalf("SchemaID returned the same ID %q for two different schema definitions", id1)
+	}
+}
+
+func TestInMemorySchemaRegistryIsIdempotent(t *testing.T) {
+	registry := NewInMemorySchemaRegistry()
+	ctx := context.Background()
+	definition := `{"type":"record","name":"Order","fields":[]}`
+
+	first, err := registry.SchemaID(ctx, "orders", definition)
+	if err != nil {
+		t.Fatalf("SchemaID returned error: %v", err)
+	}
+
+	second, err := registry.SchemaID(ctx, "orders", definition)
+	if err != nil {
+		t.Fatalf("SchemaID returned error: %v", err)
+	}
+
+	if first != second {
+		t.Fatalf("SchemaID returned %q then %q for the same (subject, definition) pair, want the same ID both times", first, second)
+	}
+}
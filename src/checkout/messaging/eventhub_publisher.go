@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/open-telemetry/opentelemetry-demo/src/checkout/eventhub"
+)
+
+// EventHubPublisher adapts an eventhub.EventHubProducer to the Publisher
+// interface.
+type EventHubPublisher struct {
+	producer *eventhub.EventHubProducer
+}
+
+func newEventHubPublisher(config eventhub.EventHubConfig, logger *slog.Logger) (*EventHubPublisher, error) {
+	producer, err := eventhub.CreateEventHubProducer(config, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EventHub publisher: %v", err)
+	}
+	return &EventHubPublisher{producer: producer}, nil
+}
+
+// Publish implements Publisher. topic is accepted for interface symmetry
+// with the other backends; EventHubPublisher always publishes to the
+// EventHub entity it was configured with. key becomes the event's
+// PartitionKey, and headers (with the current OTel trace context injected
+// into them) are carried as EventData.Properties.
+func (p *EventHubPublisher) Publish(ctx context.Context, topic string, key []byte, payload []byte, headers map[string]string) error {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(headers))
+
+	event := eventhub.Event{
+		Body:       payload,
+		Properties: propertiesFromHeaders(headers),
+	}
+	if len(key) > 0 {
+		partitionKey := string(key)
+		event.PartitionKey = &partitionKey
+	}
+
+	return p.producer.SendEvents(ctx, []eventhub.Event{event})
+}
+
+func propertiesFromHeaders(headers map[string]string) map[string]any {
+	properties := make(map[string]any, len(headers))
+	for k, v := range headers {
+		properties[k] = v
+	}
+	return properties
+}
+
+// Close implements Publisher.
+func (p *EventHubPublisher) Close() error {
+	return p.producer.Close(context.Background())
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaConfig holds configuration for the Kafka publisher.
+type KafkaConfig struct {
+	Brokers []string // Kafka bootstrap broker addresses
+	Topic   string   // Kafka topic name reported in the startup log (defaults to TopicName); Publish writes to its topic argument
+}
+
+// KafkaPublisher implements Publisher on top of a segmentio/kafka-go Writer.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+	logger *slog.Logger
+}
+
+func newKafkaPublisher(config KafkaConfig, logger *slog.Logger) (*KafkaPublisher, error) {
+	if len(config.Brokers) == 0 {
+		return nil, fmt.Errorf("at least one Kafka broker address is required")
+	}
+
+	if config.Topic == "" {
+		config.Topic = TopicName
+	}
+
+	// Topic is deliberately left unset on the Writer: kafka-go rejects a
+	// message that carries a Topic once Writer.Topic is also set, and
+	// Publish always sets msg.Topic per call so callers can target any
+	// topic, not just config.Topic.
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(config.Brokers...),
+		Balancer: &kafka.Hash{},
+	}
+
+	logger.Info("Kafka publisher created successfully", slog.String("topic", config.Topic))
+
+	return &KafkaPublisher{writer: writer, logger: logger}, nil
+}
+
+// Publish implements Publisher.
+func (p *KafkaPublisher) Publish(ctx context.Context, topic string, key []byte, payload []byte, headers map[string]string) error {
+	msg := kafka.Message{
+		Topic:   topic,
+		Key:     key,
+		Value:   payload,
+		Headers: headersToKafka(headers),
+	}
+
+	if err := p.writer.WriteMessages(ctx, msg); err != nil {
+		return fmt.Errorf("failed to write Kafka message: %v", err)
+	}
+
+	p.logger.Info("Message published successfully to Kafka",
+		slog.String("topic", topic), slog.Int("message_size", len(payload)))
+
+	return nil
+}
+
+func headersToKafka(headers map[string]string) []kafka.Header {
+	kafkaHeaders := make([]kafka.Header, 0, len(headers))
+	for k, v := range headers {
+		kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	return kafkaHeaders
+}
+
+// Close implements Publisher.
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSConfig holds configuration for the NATS JetStream publisher.
+type NATSConfig struct {
+	URL    string // NATS server URL, e.g. nats://nats:4222
+	Stream string // JetStream stream name (created if it doesn't already exist)
+}
+
+// NATSPublisher implements Publisher on top of a NATS JetStream context.
+type NATSPublisher struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+func newNATSPublisher(ctx context.Context, config NATSConfig, logger *slog.Logger) (*NATSPublisher, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("NATS server URL is required")
+	}
+
+	conn, err := nats.Connect(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %v", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %v", err)
+	}
+
+	if config.Stream != "" {
+		if _, err := js.StreamInfo(config.Stream); err != nil {
+			if _, err := js.AddStream(&nats.StreamConfig{Name: config.Stream, Subjects: []string{config.Stream + ".>"}}); err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("failed to create JetStream stream: %v", err)
+			}
+		}
+	}
+
+	logger.Info("NATS JetStream publisher created successfully", slog.String("url", config.URL))
+
+	return &NATSPublisher{conn: conn, js: js}, nil
+}
+
+// Publish implements Publisher.
+func (p *NATSPublisher) Publish(ctx context.Context, topic string, key []byte, payload []byte, headers map[string]string) error {
+	msg := nats.NewMsg(topic)
+	msg.Data = payload
+	for k, v := range headers {
+		msg.Header.Set(k, v)
+	}
+	if len(key) > 0 {
+		msg.Header.Set("Nats-Msg-Key", string(key))
+	}
+
+	if _, err := p.js.PublishMsg(msg, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to publish NATS message: %v", err)
+	}
+
+	return nil
+}
+
+// Close implements Publisher.
+func (p *NATSPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package messaging defines a broker-agnostic publisher abstraction so the
+// checkout service can be redeployed against Kafka, Azure Event Hubs, or
+// NATS JetStream without code changes.
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/open-telemetry/opentelemetry-demo/src/checkout/eventhub"
+)
+
+var (
+	// TopicName is the default topic/entity name for order events.
+	TopicName = "orders"
+)
+
+// Backend selects which messaging system a Publisher talks to.
+type Backend string
+
+const (
+	BackendKafka    Backend = "kafka"
+	BackendEventHub Backend = "eventhub"
+	BackendNATS     Backend = "nats"
+)
+
+// Publisher publishes a payload to a topic, optionally keyed for
+// partitioning, carrying propagation headers (e.g. traceparent) alongside
+// the payload.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key []byte, payload []byte, headers map[string]string) error
+	Close() error
+}
+
+// Config selects and configures the messaging backend used by checkout.
+type Config struct {
+	Backend Backend
+
+	Kafka    KafkaConfig
+	EventHub eventhub.EventHubConfig
+	NATS     NATSConfig
+}
+
+// BackendFromEnv reads MESSAGING_BACKEND (defaulting to BackendKafka) so the
+// demo can be redeployed against a different broker without code changes.
+func BackendFromEnv() Backend {
+	if v := os.Getenv("MESSAGING_BACKEND"); v != "" {
+		return Backend(v)
+	}
+	return BackendKafka
+}
+
+// NewPublisher creates the Publisher selected by config.Backend.
+func NewPublisher(ctx context.Context, config Config, logger *slog.Logger) (Publisher, error) {
+	switch config.Backend {
+	case "", BackendKafka:
+		return newKafkaPublisher(config.Kafka, logger)
+	case BackendEventHub:
+		return newEventHubPublisher(config.EventHub, logger)
+	case BackendNATS:
+		return newNATSPublisher(ctx, config.NATS, logger)
+	default:
+		return nil, fmt.Errorf("unknown messaging backend %q", config.Backend)
+	}
+}